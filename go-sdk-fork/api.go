@@ -0,0 +1,180 @@
+package deltadefi
+
+import (
+	"fmt"
+
+	rum "github.com/sidan-lab/rum"
+	"github.com/sidan-lab/rum/wallet"
+)
+
+func (d *DeltaDeFi) LoadOperationKey(passcode string) error {
+	if d.keyProvider != nil {
+		providerPasscode, err := d.keyProvider.OperationPasscode()
+		if err != nil {
+			return fmt.Errorf("failed to read operation passcode from key provider: %w", err)
+		}
+		passcode = providerPasscode
+	}
+
+	res, err := d.Accounts.GetOperationKey()
+	if err != nil {
+		return err
+	}
+
+	operationKey, err := rum.DecryptWithCipher(res.EncryptedOperationKey, passcode)
+	if err != nil {
+		return err
+	}
+
+	operationWallet, err := wallet.NewRootKeyWallet(operationKey, wallet.NewDerivationIndices())
+	if err != nil {
+		return err
+	}
+
+	d.OperationWallet = operationWallet
+	return nil
+}
+
+func (d *DeltaDeFi) PostOrder(data *BuildPlaceOrderTransactionRequest) (*SubmitPlaceOrderTransactionResponse, error) {
+	signer, err := d.signer()
+	if err != nil {
+		return nil, err
+	}
+
+	buildRes, err := d.Order.BuildPlaceOrderTransaction(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.client.logger != nil {
+		d.client.logger.Debug("deltadefi: built order", "orderId", buildRes.OrderID, "txHex", buildRes.TxHex)
+	}
+	signedTx, err := signer.SignTransaction(buildRes.TxHex)
+	if err != nil {
+		return nil, err
+	}
+
+	submitRes, err := d.Order.SubmitPlaceOrderTransactionRequest(&SubmitPlaceOrderTransactionRequest{
+		OrderID:  buildRes.OrderID,
+		SignedTx: signedTx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return submitRes, nil
+}
+
+func (d *DeltaDeFi) CancelOrder(orderId string) (*SubmitCancelOrderTransactionResponse, error) {
+	signer, err := d.signer()
+	if err != nil {
+		return nil, err
+	}
+
+	buildRes, err := d.Order.BuildCancelOrderTransaction(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx, err := signer.SignTransaction(buildRes.TxHex)
+	if err != nil {
+		return nil, err
+	}
+
+	submitRes, err := d.Order.SubmitCancelOrderTransactionRequest(&SubmitCancelOrderTransactionRequest{
+		SignedTx: signedTx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return submitRes, nil
+}
+
+// OrderLegResult is the outcome of one leg of a PostOrders batch call. Err
+// is nil when that leg succeeded.
+type OrderLegResult struct {
+	Order *OrderJSON
+	Err   error
+}
+
+// PostOrdersResponse reports the per-leg outcome of a PostOrders call.
+type PostOrdersResponse struct {
+	Results []OrderLegResult
+}
+
+// PostOrders places multiple orders using the already-loaded operation
+// wallet. The order-build endpoint and the wallet signer both only operate
+// on one order at a time, so this is a convenience wrapper around N
+// independent build/sign/submit round trips, not a single batched
+// transaction or signing call — it does not reduce the number of round
+// trips a caller-side loop over PostOrder would make. What it adds over
+// that loop is that a failure on one leg does not abort the rest; check
+// each result's Err to see which legs succeeded.
+func (d *DeltaDeFi) PostOrders(requests []*BuildPlaceOrderTransactionRequest) (*PostOrdersResponse, error) {
+	results := make([]OrderLegResult, len(requests))
+	for i, req := range requests {
+		res, err := d.PostOrder(req)
+		if err != nil {
+			results[i] = OrderLegResult{Err: err}
+			continue
+		}
+		results[i] = OrderLegResult{Order: &res.Order}
+	}
+	return &PostOrdersResponse{Results: results}, nil
+}
+
+// CancelLegResult is the outcome of one leg of a CancelOrders batch call.
+type CancelLegResult struct {
+	OrderID string
+	Err     error
+}
+
+// CancelOrdersResponse reports the per-leg outcome of a CancelOrders call.
+type CancelOrdersResponse struct {
+	Results []CancelLegResult
+}
+
+// CancelOrders cancels multiple orders using the already-loaded operation
+// wallet. Like PostOrders, each leg is still its own independent
+// build/sign/submit round trip; a failure on one leg does not abort the
+// rest, so check each result's Err to see which legs succeeded.
+func (d *DeltaDeFi) CancelOrders(orderIds []string) (*CancelOrdersResponse, error) {
+	results := make([]CancelLegResult, len(orderIds))
+	for i, orderId := range orderIds {
+		_, err := d.CancelOrder(orderId)
+		results[i] = CancelLegResult{OrderID: orderId, Err: err}
+	}
+	return &CancelOrdersResponse{Results: results}, nil
+}
+
+// CancelAllOrders cancels every open order for symbol by looking up the
+// account's open order records and cancelling them via CancelOrders.
+func (d *DeltaDeFi) CancelAllOrders(symbol string) error {
+	records, err := d.Accounts.GetOrderRecords(&GetOrderRecordRequest{
+		Status: OrderRecordStatusOpenOrder,
+		Symbol: Symbol(symbol),
+	})
+	if err != nil {
+		return err
+	}
+
+	var orderIds []string
+	for _, data := range records.Data {
+		for _, order := range data.Orders {
+			orderIds = append(orderIds, order.OrderID)
+		}
+	}
+	if len(orderIds) == 0 {
+		return nil
+	}
+
+	cancelRes, err := d.CancelOrders(orderIds)
+	if err != nil {
+		return err
+	}
+	for _, leg := range cancelRes.Results {
+		if leg.Err != nil {
+			return fmt.Errorf("failed to cancel order %s: %w", leg.OrderID, leg.Err)
+		}
+	}
+	return nil
+}
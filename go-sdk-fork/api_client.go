@@ -0,0 +1,296 @@
+package deltadefi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	rum "github.com/sidan-lab/rum/wallet"
+)
+
+type DeltaDeFi struct {
+	Accounts        *AccountsClient
+	Market          *MarketClient
+	Order           *OrderClient
+	Stream          *StreamClient
+	MasterWallet    *rum.Wallet
+	OperationWallet *rum.Wallet
+	client          *Client
+	keyProvider     KeyProvider
+	txSigner        TxSigner
+}
+
+// Option configures a DeltaDeFi client at construction time.
+type Option func(*DeltaDeFi)
+
+// WithKeyProvider makes LoadOperationKey read the passcode that decrypts the
+// operation key from the given KeyProvider instead of its passcode argument.
+// The provider supplies only that passcode; transactions are still signed by
+// the decrypted OperationWallet in this process, or by a TxSigner set with
+// WithTxSigner. See the keystore subpackage for provider implementations.
+func WithKeyProvider(provider KeyProvider) Option {
+	return func(d *DeltaDeFi) {
+		d.keyProvider = provider
+	}
+}
+
+// WithTxSigner routes PostOrder/CancelOrder signing through signer instead
+// of OperationWallet's local rum signer. Use this for a signer whose
+// private key never leaves a separate process or device (e.g.
+// keystore.HardwareWalletProvider); LoadOperationKey does not need to be
+// called when a TxSigner is configured, since no software wallet is held
+// in-process.
+func WithTxSigner(signer TxSigner) Option {
+	return func(d *DeltaDeFi) {
+		d.txSigner = signer
+	}
+}
+
+// signer returns the TxSigner used to sign order transactions: the
+// explicitly configured TxSigner if one was set via WithTxSigner, otherwise
+// OperationWallet's local rum signer, which requires LoadOperationKey to
+// have been called first.
+func (d *DeltaDeFi) signer() (TxSigner, error) {
+	if d.txSigner != nil {
+		return d.txSigner, nil
+	}
+	if d.OperationWallet == nil {
+		return nil, fmt.Errorf("operation wallet is not loaded")
+	}
+	return d.OperationWallet.Signer(), nil
+}
+
+func NewDeltaDeFi(cfg ApiConfig, opts ...Option) *DeltaDeFi {
+	client := newClient(cfg)
+	d := &DeltaDeFi{
+		Accounts:        newAccountsClient(client),
+		Market:          newMarketClient(client),
+		Order:           newOrderClient(client),
+		Stream:          newStreamClient(client),
+		MasterWallet:    nil,
+		OperationWallet: nil,
+		client:          client,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+type Client struct {
+	ApiKey            string
+	NetworkId         uint8
+	OperationPasscode string
+	HTTPClient        *http.Client
+	BaseURL           string
+	WsURL             string
+	logger            *slog.Logger
+	limiter           *rateLimiter
+	endpointLimiters  map[string]*rateLimiter
+}
+
+func newClient(cfg ApiConfig) *Client {
+	var networkId uint8
+	var baseURL, wsURL string
+
+	if cfg.Network == "mainnet" {
+		networkId = uint8(1)
+		baseURL = "https://api-staging.deltadefi.io" // TODO: input production link once available
+		wsURL = "wss://stream.deltadefi.io"
+	} else if cfg.Network == "staging" {
+		networkId = uint8(0)
+		baseURL = "https://api-staging.deltadefi.io"
+		wsURL = "wss://stream-staging.deltadefi.io"
+	} else {
+		networkId = uint8(0)
+		baseURL = "https://api-dev.deltadefi.io"
+		wsURL = "wss://stream-dev.deltadefi.io"
+	}
+
+	if (cfg.ProvidedBaseUrl) != "" {
+		baseURL = cfg.ProvidedBaseUrl
+	}
+
+	retryClient := retryablehttp.NewClient()
+	if cfg.MaxRetries > 0 {
+		retryClient.RetryMax = cfg.MaxRetries
+	}
+	if cfg.RetryWaitMin > 0 {
+		retryClient.RetryWaitMin = cfg.RetryWaitMin
+	}
+	if cfg.RetryWaitMax > 0 {
+		retryClient.RetryWaitMax = cfg.RetryWaitMax
+	}
+	retryClient.HTTPClient.Timeout = 5 * time.Minute
+	retryClient.Logger = nil
+	if cfg.Logger != nil {
+		retryClient.Logger = cfg.Logger
+	}
+	retryClient.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+		if cfg.Logger != nil {
+			cfg.Logger.Debug("deltadefi: request", "method", req.Method, "url", req.URL.String(), "attempt", attempt)
+		}
+	}
+	// Exponential backoff (RetryWaitMin doubled per attempt, capped at
+	// RetryWaitMax) with a random jitter between RetryWaitMin and that
+	// ceiling; 429/503 responses carrying a Retry-After header wait exactly
+	// that long instead of the jittered backoff.
+	retryClient.Backoff = func(waitMin, waitMax time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) &&
+			resp.Header.Get("Retry-After") != "" {
+			return retryablehttp.DefaultBackoff(waitMin, waitMax, attemptNum, resp)
+		}
+		ceiling := retryablehttp.DefaultBackoff(waitMin, waitMax, attemptNum, nil)
+		if ceiling <= waitMin {
+			return ceiling
+		}
+		return waitMin + time.Duration(rand.Int63n(int64(ceiling-waitMin)))
+	}
+	// Hand the last response back when retries run out so do() can turn a
+	// final 429/5xx into a typed error instead of a generic giving-up error.
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	endpointLimiters := make(map[string]*rateLimiter, len(cfg.PerEndpointQPS))
+	for prefix, qps := range cfg.PerEndpointQPS {
+		endpointLimiters[prefix] = newRateLimiter(qps)
+	}
+
+	return &Client{
+		ApiKey:            cfg.ApiKey,
+		NetworkId:         networkId,
+		OperationPasscode: cfg.OperationPasscode,
+		HTTPClient:        retryClient.StandardClient(),
+		BaseURL:           baseURL,
+		WsURL:             wsURL,
+		logger:            cfg.Logger,
+		limiter:           newRateLimiter(cfg.RequestsPerSecond),
+		endpointLimiters:  endpointLimiters,
+	}
+}
+
+// limiterFor returns the rate limiter governing path: the limiter for the
+// longest configured PerEndpointQPS prefix that path matches, or the
+// client's default limiter if none match.
+func (c *Client) limiterFor(path string) *rateLimiter {
+	var bestPrefix string
+	var best *rateLimiter
+	for prefix, limiter := range c.endpointLimiters {
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(bestPrefix) {
+			bestPrefix = prefix
+			best = limiter
+		}
+	}
+	if best != nil {
+		return best
+	}
+	return c.limiter
+}
+
+// do executes req, honoring the client's rate limiter, logging the
+// method/URL/latency/status at debug level, and translating non-2xx
+// responses into the typed errors in errors.go.
+func (c *Client) do(req *http.Request, logMethod, logURL string) ([]byte, error) {
+	limiter := c.limiterFor(req.URL.Path)
+	limiter.wait()
+
+	start := time.Now()
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		if c.logger != nil {
+			c.logger.Debug("deltadefi: request failed", "method", logMethod, "url", logURL, "latency", time.Since(start), "error", err)
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	limiter.updateFromHeaders(resp.Header)
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.logger != nil {
+		c.logger.Debug("deltadefi: request completed", "method", logMethod, "url", logURL, "latency", time.Since(start), "status", resp.StatusCode)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return bodyBytes, classifyStatusError(resp.StatusCode, resp.Header, bodyBytes)
+	}
+
+	return bodyBytes, nil
+}
+
+func (c *Client) get(url string) ([]byte, error) {
+	req, err := http.NewRequest("GET", c.BaseURL+url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Add("X-API-KEY", c.ApiKey)
+
+	return c.do(req, "GET", c.BaseURL+url)
+}
+
+func (c *Client) getWithParams(path string, params map[string]string) ([]byte, error) {
+	req, err := http.NewRequest("GET", c.BaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add query parameters
+	q := req.URL.Query()
+	for key, value := range params {
+		q.Add(key, value)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	// Add headers
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Add("X-API-KEY", c.ApiKey)
+
+	return c.do(req, "GET", req.URL.String())
+}
+
+func (c *Client) post(url string, body interface{}) ([]byte, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", c.BaseURL+url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Add("X-API-KEY", c.ApiKey)
+
+	return c.do(req, "POST", c.BaseURL+url)
+}
+
+func (c *Client) delete(url string, body interface{}) ([]byte, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("DELETE", c.BaseURL+url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Add("X-API-KEY", c.ApiKey)
+
+	return c.do(req, "DELETE", c.BaseURL+url)
+}
@@ -0,0 +1,72 @@
+package deltadefi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type MarketClient struct {
+	pathUrl string
+	client  *Client
+}
+
+func newMarketClient(client *Client) *MarketClient {
+	return &MarketClient{
+		pathUrl: "/market",
+		client:  client,
+	}
+}
+
+func (c *MarketClient) GetMarketPrice(symbol string) (*GetMarketPriceResponse, error) {
+	params := make(map[string]string)
+	params["symbol"] = symbol
+
+	bodyBytes, err := c.client.getWithParams(c.pathUrl+"/market-price", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var getMarketPriceResponse GetMarketPriceResponse
+	err = json.Unmarshal(bodyBytes, &getMarketPriceResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &getMarketPriceResponse, nil
+}
+
+// GetOrderbookDepth fetches the current order book snapshot for symbol. The
+// returned LastUpdateID lines up with StreamClient depth updates, so callers
+// that maintain a local book can use it to know which buffered updates to
+// discard versus apply.
+func (c *MarketClient) GetOrderbookDepth(symbol string) (*GetMarketDepthResponse, error) {
+	params := make(map[string]string)
+	params["symbol"] = symbol
+
+	bodyBytes, err := c.client.getWithParams(c.pathUrl+"/depth", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var getMarketDepthResponse GetMarketDepthResponse
+	err = json.Unmarshal(bodyBytes, &getMarketDepthResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &getMarketDepthResponse, nil
+}
+
+func (c *MarketClient) GetAggregatedPrice(data *GetAggregatedPriceRequest) (*GetAggregatedPriceResponse, error) {
+	fullPath := c.pathUrl + "/graph/" + string(data.Symbol) + "?interval=" + string(data.Interval) +
+		"&start=" + fmt.Sprint(data.Start) + "&end=" + fmt.Sprint(data.End)
+	bodyBytes, err := c.client.get(fullPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var getAggregatedPriceResponse GetAggregatedPriceResponse
+	err = json.Unmarshal(bodyBytes, &getAggregatedPriceResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &getAggregatedPriceResponse, nil
+}
@@ -0,0 +1,372 @@
+// Package backtest replays historical klines through a simulated matching
+// engine so a strategy can be tested against SimulatedClient's
+// PostOrder/CancelOrder instead of the live dd.DeltaDeFi client.
+package backtest
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+)
+
+// Config configures a backtest run.
+type Config struct {
+	// Client fetches the historical candles to replay via
+	// Client.Market.GetAggregatedPrice. Its operation key does not need to
+	// be loaded since no real orders are submitted.
+	Client *dd.DeltaDeFi
+	// Symbols are the trading pairs to replay.
+	Symbols []string
+	// Interval is the candle interval to fetch (see the Interval constants
+	// in the root package).
+	Interval dd.Interval
+	Start    time.Time
+	End      time.Time
+	// MakerFeeBps and TakerFeeBps are charged, in basis points of notional,
+	// on resting limit orders filled by a later bar and on immediate fills
+	// (market orders and already-marketable limit orders) respectively.
+	MakerFeeBps float64
+	TakerFeeBps float64
+	// InitialBalances seeds the simulated account, keyed by asset ticker.
+	InitialBalances map[string]float64
+}
+
+type openOrder struct {
+	orderID string
+	symbol  string
+	side    dd.OrderSide
+	kind    dd.OrderType
+	price   float64
+	qty     float64
+}
+
+// Report summarizes a completed backtest run.
+type Report struct {
+	PnL            float64
+	Sharpe         float64
+	MaxDrawdownPct float64
+	WinRatePct     float64
+	FeesPaid       float64
+}
+
+// SimulatedClient replays historical bars and matches orders against them,
+// exposing enough of dd.DeltaDeFi's surface (PostOrder, CancelOrder) that a
+// strategy can run unmodified against either.
+type SimulatedClient struct {
+	config  Config
+	bars    map[string][]dd.Candlestick
+	cursor  int
+	barCap  int
+	nextID  int
+	orders  map[string]*openOrder
+	balance map[string]float64
+	// costBasis is the running weighted-average acquisition price per
+	// asset, updated on every buy fill and read (not updated) on sells.
+	costBasis map[string]float64
+
+	equityCurve []float64
+	closedPnLs  []float64
+	feesPaid    float64
+}
+
+// NewSimulatedClient fetches historical candles for config.Symbols over
+// [config.Start, config.End) and prepares a SimulatedClient ready to Advance
+// through them.
+func NewSimulatedClient(config Config) (*SimulatedClient, error) {
+	if config.Client == nil {
+		return nil, fmt.Errorf("backtest: Config.Client is required to fetch historical candles")
+	}
+
+	bars := make(map[string][]dd.Candlestick, len(config.Symbols))
+	barCap := -1
+	for _, symbol := range config.Symbols {
+		res, err := config.Client.Market.GetAggregatedPrice(&dd.GetAggregatedPriceRequest{
+			Symbol:   dd.Symbol(symbol),
+			Interval: config.Interval,
+			Start:    config.Start.Unix(),
+			End:      config.End.Unix(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("backtest: failed to fetch candles for %s: %w", symbol, err)
+		}
+		bars[symbol] = *res
+		if barCap == -1 || len(*res) < barCap {
+			barCap = len(*res)
+		}
+	}
+	if barCap < 0 {
+		barCap = 0
+	}
+
+	balance := make(map[string]float64, len(config.InitialBalances))
+	for asset, qty := range config.InitialBalances {
+		balance[asset] = qty
+	}
+
+	client := &SimulatedClient{
+		config:    config,
+		bars:      bars,
+		cursor:    0,
+		barCap:    barCap,
+		orders:    make(map[string]*openOrder),
+		balance:   balance,
+		costBasis: make(map[string]float64, len(config.InitialBalances)),
+	}
+	if barCap > 0 {
+		// Seed the cost basis of any pre-funded asset at the first bar's
+		// close, since InitialBalances carries no acquisition price of its
+		// own to realize PnL against.
+		for asset, qty := range config.InitialBalances {
+			if qty > 0 {
+				if price := client.markPrice(asset); price > 0 {
+					client.costBasis[asset] = price
+				}
+			}
+		}
+		client.equityCurve = append(client.equityCurve, client.equity())
+	}
+	return client, nil
+}
+
+// Advance matches any resting limit orders against the next bar and moves
+// the cursor onto it, returning false once the shortest symbol's series is
+// exhausted. CurrentBar always reflects the bar at the cursor, so callers
+// read CurrentBar before calling Advance, e.g.:
+//
+//	for {
+//	    bar := client.CurrentBar(symbol)
+//	    ...
+//	    if !client.Advance() {
+//	        break
+//	    }
+//	}
+func (c *SimulatedClient) Advance() bool {
+	if c.cursor+1 >= c.barCap {
+		return false
+	}
+	c.cursor++
+	for symbol := range c.bars {
+		c.matchRestingOrders(symbol, c.bars[symbol][c.cursor])
+	}
+	c.equityCurve = append(c.equityCurve, c.equity())
+	return true
+}
+
+// CurrentBar returns the bar at the current cursor position for symbol.
+func (c *SimulatedClient) CurrentBar(symbol string) dd.Candlestick {
+	return c.bars[symbol][c.cursor]
+}
+
+// PostOrder simulates placing an order against the current bar. Market
+// orders fill immediately at the current close; limit orders fill
+// immediately if already marketable, otherwise rest until a later bar's
+// high/low crosses the limit price.
+func (c *SimulatedClient) PostOrder(data *dd.BuildPlaceOrderTransactionRequest) (*dd.SubmitPlaceOrderTransactionResponse, error) {
+	bar := c.bars[string(data.Symbol)][c.cursor]
+
+	c.nextID++
+	order := &openOrder{
+		orderID: fmt.Sprintf("backtest-%d", c.nextID),
+		symbol:  string(data.Symbol),
+		side:    data.Side,
+		kind:    data.Type,
+		qty:     data.Quantity,
+	}
+	if data.Price != nil {
+		order.price = *data.Price
+	}
+
+	if data.Type == dd.OrderTypeMarket {
+		c.fill(order, bar.Close, c.config.TakerFeeBps)
+		return &dd.SubmitPlaceOrderTransactionResponse{
+			Order: dd.OrderJSON{OrderID: order.orderID},
+		}, nil
+	}
+
+	if marketable(order, bar) {
+		c.fill(order, order.price, c.config.TakerFeeBps)
+	} else {
+		c.orders[order.orderID] = order
+	}
+
+	return &dd.SubmitPlaceOrderTransactionResponse{
+		Order: dd.OrderJSON{OrderID: order.orderID},
+	}, nil
+}
+
+// CancelOrder removes a still-resting order. Canceling an order that has
+// already filled is a no-op, matching the live API's idempotent behavior.
+func (c *SimulatedClient) CancelOrder(orderId string) (*dd.SubmitCancelOrderTransactionResponse, error) {
+	delete(c.orders, orderId)
+	return &dd.SubmitCancelOrderTransactionResponse{}, nil
+}
+
+// Report computes summary statistics over the replayed run so far.
+func (c *SimulatedClient) Report() Report {
+	var wins int
+	var pnl float64
+	for _, p := range c.closedPnLs {
+		pnl += p
+		if p > 0 {
+			wins++
+		}
+	}
+
+	winRate := 0.0
+	if len(c.closedPnLs) > 0 {
+		winRate = float64(wins) / float64(len(c.closedPnLs)) * 100
+	}
+
+	return Report{
+		PnL:            pnl,
+		Sharpe:         sharpeRatio(c.equityCurve),
+		MaxDrawdownPct: maxDrawdownPct(c.equityCurve),
+		WinRatePct:     winRate,
+		FeesPaid:       c.feesPaid,
+	}
+}
+
+func marketable(order *openOrder, bar dd.Candlestick) bool {
+	if order.side == dd.OrderSideBuy {
+		return bar.Low <= order.price
+	}
+	return bar.High >= order.price
+}
+
+func (c *SimulatedClient) matchRestingOrders(symbol string, bar dd.Candlestick) {
+	for id, order := range c.orders {
+		if order.symbol != symbol {
+			continue
+		}
+		if marketable(order, bar) {
+			c.fill(order, order.price, c.config.MakerFeeBps)
+			delete(c.orders, id)
+		}
+	}
+}
+
+// fill settles order at price, updating balances, the running cost basis
+// for buys, realized PnL against that cost basis for sells, and fees paid.
+func (c *SimulatedClient) fill(order *openOrder, price float64, feeBps float64) {
+	base, quote, err := splitSymbol(order.symbol)
+	if err != nil {
+		return
+	}
+
+	notional := order.qty * price
+	fee := notional * feeBps / 10000
+	c.feesPaid += fee
+
+	if order.side == dd.OrderSideBuy {
+		c.addLot(base, order.qty, price)
+		c.balance[base] += order.qty
+		c.balance[quote] -= notional + fee
+	} else {
+		entryValue := c.costBasis[base] * order.qty
+		c.closedPnLs = append(c.closedPnLs, notional-entryValue-fee)
+		c.balance[base] -= order.qty
+		c.balance[quote] += notional - fee
+	}
+}
+
+// addLot folds a new buy of qty at price into the running weighted-average
+// cost basis for asset.
+func (c *SimulatedClient) addLot(asset string, qty, price float64) {
+	existingQty := c.balance[asset]
+	if existingQty <= 0 {
+		c.costBasis[asset] = price
+		return
+	}
+	totalCost := c.costBasis[asset]*existingQty + price*qty
+	c.costBasis[asset] = totalCost / (existingQty + qty)
+}
+
+// markPrice returns the current bar's close for whichever symbol has asset
+// as its base, for marking open positions to market in equity().
+func (c *SimulatedClient) markPrice(asset string) float64 {
+	for symbol, bars := range c.bars {
+		base, _, err := splitSymbol(symbol)
+		if err == nil && base == asset && c.cursor < len(bars) {
+			return bars[c.cursor].Close
+		}
+	}
+	return 0
+}
+
+func (c *SimulatedClient) equity() float64 {
+	var total float64
+	for asset, qty := range c.balance {
+		if qty == 0 {
+			continue
+		}
+		if price := c.markPrice(asset); price > 0 {
+			total += qty * price
+		} else {
+			total += qty // quote asset, already in its own units
+		}
+	}
+	return total
+}
+
+func splitSymbol(symbol string) (base, quote string, err error) {
+	for _, q := range []string{"USDM", "USDC"} {
+		if len(symbol) > len(q) && symbol[len(symbol)-len(q):] == q {
+			return symbol[:len(symbol)-len(q)], q, nil
+		}
+	}
+	return "", "", fmt.Errorf("backtest: unrecognized symbol %q", symbol)
+}
+
+func sharpeRatio(equity []float64) float64 {
+	if len(equity) < 2 {
+		return 0
+	}
+	returns := make([]float64, 0, len(equity)-1)
+	for i := 1; i < len(equity); i++ {
+		if equity[i-1] == 0 {
+			continue
+		}
+		returns = append(returns, (equity[i]-equity[i-1])/equity[i-1])
+	}
+	if len(returns) == 0 {
+		return 0
+	}
+
+	var mean float64
+	for _, r := range returns {
+		mean += r
+	}
+	mean /= float64(len(returns))
+
+	var variance float64
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+	}
+	variance /= float64(len(returns))
+	stddev := math.Sqrt(variance)
+	if stddev == 0 {
+		return 0
+	}
+	return mean / stddev * math.Sqrt(float64(len(returns)))
+}
+
+func maxDrawdownPct(equity []float64) float64 {
+	if len(equity) == 0 {
+		return 0
+	}
+	peak := equity[0]
+	var maxDrawdown float64
+	for _, v := range equity {
+		if v > peak {
+			peak = v
+		}
+		if peak > 0 {
+			if drawdown := (peak - v) / peak; drawdown > maxDrawdown {
+				maxDrawdown = drawdown
+			}
+		}
+	}
+	return maxDrawdown * 100
+}
@@ -0,0 +1,45 @@
+package deltadefi
+
+import (
+	"log/slog"
+	"time"
+)
+
+type ApiConfig struct {
+	Network           ApiNetwork
+	ApiKey            string
+	OperationPasscode string
+	ProvidedBaseUrl   string
+
+	// MaxRetries is the maximum number of retry attempts for requests that
+	// fail with a retryable error (network errors, 429s, 5xxs). Defaults
+	// to the underlying retryablehttp client's default (4) when zero.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff applied
+	// between retries. Both default to the underlying retryablehttp
+	// client's defaults (1s/30s) when zero.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// RequestsPerSecond caps the client's outbound request rate through a
+	// token-bucket limiter. Unlimited when zero. Regardless of this
+	// setting, the limiter is also tightened in place whenever a response
+	// carries X-RateLimit-Limit/Remaining/Reset headers advertising a
+	// stricter quota than the current rate.
+	RequestsPerSecond float64
+	// PerEndpointQPS overrides RequestsPerSecond for requests whose path
+	// has the given prefix as a key, e.g. {"/order": 2} caps order
+	// build/submit calls to 2 QPS while other endpoints use
+	// RequestsPerSecond. The longest matching prefix wins.
+	PerEndpointQPS map[string]float64
+	// Logger receives structured request/response logging at debug level.
+	// Nil disables logging.
+	Logger *slog.Logger
+}
+
+type ApiNetwork string
+
+const (
+	ApiNetworkDev     ApiNetwork = "dev"
+	ApiNetworkStaging ApiNetwork = "staging"
+	ApiNetworkMainnet ApiNetwork = "mainnet"
+)
@@ -0,0 +1,76 @@
+package deltadefi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitError indicates the API rejected the request due to rate
+// limiting (HTTP 429). RetryAfter reflects the server's Retry-After header
+// when present.
+type RateLimitError struct {
+	RetryAfter time.Duration
+	Body       string
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("deltadefi: rate limited, retry after %s", e.RetryAfter)
+}
+
+// AuthError indicates the API rejected the request's credentials (HTTP 401/403).
+type AuthError struct {
+	Message string
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("deltadefi: auth error: %s", e.Message)
+}
+
+// ValidationError indicates the API rejected the request payload (HTTP 400/422).
+type ValidationError struct {
+	Fields string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("deltadefi: validation error: %s", e.Fields)
+}
+
+// ServerError indicates the API failed with a server-side error (HTTP 5xx).
+type ServerError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("deltadefi: server error (status %d): %s", e.StatusCode, e.Body)
+}
+
+// classifyStatusError maps a non-2xx response into one of the typed errors
+// above so callers can branch on failure mode instead of string-matching.
+func classifyStatusError(statusCode int, headers http.Header, body []byte) error {
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		retryAfter, _ := parseRetryAfter(headers.Get("Retry-After"))
+		return &RateLimitError{RetryAfter: retryAfter, Body: string(body)}
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return &AuthError{Message: string(body)}
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		return &ValidationError{Fields: string(body)}
+	case statusCode >= 500:
+		return &ServerError{StatusCode: statusCode, Body: string(body)}
+	default:
+		return fmt.Errorf("deltadefi: API error: %s, status code: %d", string(body), statusCode)
+	}
+}
+
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(header); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	return 0, false
+}
@@ -0,0 +1,19 @@
+package deltadefi
+
+// KeyProvider supplies the passcode used to decrypt the operation key
+// fetched from the DeltaDeFi API, so the raw passcode never has to sit in
+// a plaintext environment variable. See the keystore subpackage for
+// implementations backed by the OS keychain, HashiCorp Vault, and KMS
+// envelope encryption.
+type KeyProvider interface {
+	OperationPasscode() (string, error)
+}
+
+// TxSigner signs a built transaction and returns the signed transaction
+// hex, without requiring the caller to hold a software wallet in-process.
+// DeltaDeFi's own OperationWallet satisfies this via its rum signer; an
+// out-of-process signer (e.g. a hardware wallet bridge that never releases
+// the private key) can be substituted with WithTxSigner instead.
+type TxSigner interface {
+	SignTransaction(txHex string) (string, error)
+}
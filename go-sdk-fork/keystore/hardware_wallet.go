@@ -0,0 +1,60 @@
+package keystore
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HardwareWalletProvider signs transactions by handing the built tx hex to
+// a local bridge process that talks to a hardware wallet over USB (e.g. a
+// Ledger/Trezor companion app exposing a small HTTP API) and returns the
+// signed tx hex once the user confirms on-device. The private key never
+// leaves the device or the bridge process — it is never read into this
+// SDK's memory, unlike a software wallet decrypted from a passcode.
+//
+// HardwareWalletProvider implements deltadefi.TxSigner, not
+// deltadefi.KeyProvider: there is no operation-key passcode to supply,
+// since the hardware wallet holds the key material itself. Configure it
+// with deltadefi.WithTxSigner, not deltadefi.WithKeyProvider, and skip
+// LoadOperationKey entirely.
+type HardwareWalletProvider struct {
+	bridgeURL  string
+	httpClient *http.Client
+}
+
+// NewHardwareWalletProvider returns a provider that sends tx hexes to sign
+// to the bridge process listening at bridgeURL, e.g.
+// "http://127.0.0.1:9870/sign-transaction".
+func NewHardwareWalletProvider(bridgeURL string) (*HardwareWalletProvider, error) {
+	if bridgeURL == "" {
+		return nil, fmt.Errorf("keystore: bridgeURL is required")
+	}
+	return &HardwareWalletProvider{
+		bridgeURL:  bridgeURL,
+		httpClient: &http.Client{Timeout: 2 * time.Minute}, // user must confirm on-device
+	}, nil
+}
+
+// SignTransaction implements deltadefi.TxSigner by posting txHex to the
+// bridge and returning the signed tx hex it responds with.
+func (p *HardwareWalletProvider) SignTransaction(txHex string) (string, error) {
+	resp, err := p.httpClient.Post(p.bridgeURL, "text/plain", strings.NewReader(txHex))
+	if err != nil {
+		return "", fmt.Errorf("keystore: failed to reach hardware wallet bridge: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("keystore: failed to read hardware wallet bridge response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("keystore: hardware wallet bridge returned status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	return strings.TrimSpace(string(body)), nil
+}
@@ -0,0 +1,30 @@
+package keystore
+
+import "fmt"
+
+// KMSProvider decrypts an envelope-encrypted passcode using a caller-supplied
+// Decrypt function, so this package doesn't need to depend on any particular
+// cloud KMS SDK. Decrypt typically wraps a call like AWS KMS's Decrypt,
+// GCP KMS's Decrypt, or Azure Key Vault's unwrap-key operation.
+type KMSProvider struct {
+	ciphertext []byte
+	decrypt    func(ciphertext []byte) ([]byte, error)
+}
+
+// NewKMSProvider returns a provider that decrypts ciphertext with decrypt on
+// every call to OperationPasscode.
+func NewKMSProvider(ciphertext []byte, decrypt func(ciphertext []byte) ([]byte, error)) (*KMSProvider, error) {
+	if decrypt == nil {
+		return nil, fmt.Errorf("keystore: decrypt function is required")
+	}
+	return &KMSProvider{ciphertext: ciphertext, decrypt: decrypt}, nil
+}
+
+// OperationPasscode implements deltadefi.KeyProvider.
+func (p *KMSProvider) OperationPasscode() (string, error) {
+	plaintext, err := p.decrypt(p.ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("keystore: KMS decryption failed: %w", err)
+	}
+	return string(plaintext), nil
+}
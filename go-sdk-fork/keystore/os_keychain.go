@@ -0,0 +1,59 @@
+// Package keystore provides deltadefi.KeyProvider implementations that keep
+// the operation-key passcode out of plaintext environment variables (the OS
+// keychain, HashiCorp Vault, and a KMS envelope-decryption callback), plus a
+// deltadefi.TxSigner implementation, HardwareWalletProvider, that signs
+// transactions via a hardware wallet bridge without ever holding the
+// private key in this process.
+package keystore
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// OSKeychainProvider reads the operation passcode from the current user's OS
+// keychain: Keychain Access on macOS (via the security CLI) or the Secret
+// Service API on Linux (via secret-tool). It is not supported on other
+// platforms.
+type OSKeychainProvider struct {
+	service string
+}
+
+// NewOSKeychainProvider returns a provider that looks up the passcode stored
+// under service in the OS keychain.
+func NewOSKeychainProvider(service string) (*OSKeychainProvider, error) {
+	switch runtime.GOOS {
+	case "darwin", "linux":
+		return &OSKeychainProvider{service: service}, nil
+	default:
+		return nil, fmt.Errorf("keystore: OS keychain is not supported on %s", runtime.GOOS)
+	}
+}
+
+// OperationPasscode implements deltadefi.KeyProvider.
+func (p *OSKeychainProvider) OperationPasscode() (string, error) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("security", "find-generic-password", "-s", p.service, "-w")
+	case "linux":
+		cmd = exec.Command("secret-tool", "lookup", "service", p.service)
+	default:
+		return "", fmt.Errorf("keystore: OS keychain is not supported on %s", runtime.GOOS)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("keystore: failed to read %q from OS keychain: %w: %s", p.service, err, stderr.String())
+	}
+
+	passcode := stdout.String()
+	for len(passcode) > 0 && (passcode[len(passcode)-1] == '\n' || passcode[len(passcode)-1] == '\r') {
+		passcode = passcode[:len(passcode)-1]
+	}
+	return passcode, nil
+}
@@ -0,0 +1,97 @@
+package keystore
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// VaultProvider reads the operation passcode from a HashiCorp Vault KV v2
+// secret engine.
+type VaultProvider struct {
+	addr       string
+	token      string
+	mountPath  string
+	secretPath string
+	field      string
+	httpClient *http.Client
+}
+
+// VaultConfig configures a VaultProvider.
+type VaultConfig struct {
+	// Addr is the Vault server address, e.g. "https://vault.internal:8200".
+	Addr string
+	// Token authenticates the request via X-Vault-Token.
+	Token string
+	// MountPath is the KV v2 mount point, e.g. "secret". Defaults to "secret".
+	MountPath string
+	// SecretPath is the path under MountPath where the passcode is stored,
+	// e.g. "deltadefi/operation-key".
+	SecretPath string
+	// Field is the key within the secret's data map holding the passcode.
+	// Defaults to "passcode".
+	Field string
+}
+
+// NewVaultProvider returns a provider that fetches the operation passcode
+// from Vault on every call to OperationPasscode.
+func NewVaultProvider(cfg VaultConfig) (*VaultProvider, error) {
+	if cfg.Addr == "" || cfg.Token == "" || cfg.SecretPath == "" {
+		return nil, fmt.Errorf("keystore: Addr, Token, and SecretPath are required")
+	}
+	mountPath := cfg.MountPath
+	if mountPath == "" {
+		mountPath = "secret"
+	}
+	field := cfg.Field
+	if field == "" {
+		field = "passcode"
+	}
+	return &VaultProvider{
+		addr:       strings.TrimSuffix(cfg.Addr, "/"),
+		token:      cfg.Token,
+		mountPath:  mountPath,
+		secretPath: cfg.SecretPath,
+		field:      field,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type vaultKV2Response struct {
+	Data struct {
+		Data map[string]string `json:"data"`
+	} `json:"data"`
+}
+
+// OperationPasscode implements deltadefi.KeyProvider.
+func (p *VaultProvider) OperationPasscode() (string, error) {
+	url := fmt.Sprintf("%s/v1/%s/data/%s", p.addr, p.mountPath, p.secretPath)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("keystore: failed to reach Vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("keystore: Vault returned status %d for %s", resp.StatusCode, p.secretPath)
+	}
+
+	var body vaultKV2Response
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("keystore: failed to decode Vault response: %w", err)
+	}
+
+	passcode, ok := body.Data.Data[p.field]
+	if !ok {
+		return "", fmt.Errorf("keystore: field %q not found in Vault secret %s", p.field, p.secretPath)
+	}
+	return passcode, nil
+}
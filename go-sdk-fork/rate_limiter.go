@@ -0,0 +1,117 @@
+package deltadefi
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token-bucket limiter used to cap the client's outbound
+// request rate. It starts at the rate ApiConfig configured (or unlimited if
+// zero/unset) and is tightened in place by updateFromHeaders whenever a
+// response advertises a stricter server-side quota, so a generous static
+// config doesn't mean ignoring the server telling the client to slow down.
+// A nil *rateLimiter is a valid no-op limiter so callers don't need to
+// nil-check it.
+type rateLimiter struct {
+	mu       sync.Mutex
+	tokens   float64
+	capacity float64
+	rate     float64 // tokens/sec; +Inf means unlimited
+	last     time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	rate := math.Inf(1)
+	if requestsPerSecond > 0 {
+		rate = requestsPerSecond
+	}
+	return &rateLimiter{
+		tokens:   rate,
+		capacity: rate,
+		rate:     rate,
+		last:     time.Now(),
+	}
+}
+
+// wait blocks until a token is available, refilling the bucket based on
+// elapsed time since the last call.
+func (l *rateLimiter) wait() {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if math.IsInf(l.rate, 1) {
+		return
+	}
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.last = now
+
+	if l.tokens < 1 {
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		time.Sleep(wait)
+		l.last = time.Now()
+		l.tokens = 0
+		return
+	}
+	l.tokens--
+}
+
+// updateFromHeaders tightens the limiter's rate to match the server's
+// advertised X-RateLimit-Limit/Remaining/Reset headers (the common
+// convention: Limit and Remaining are request counts, Reset is a Unix
+// timestamp for when the window rolls over), if present and stricter than
+// the limiter's current rate. It paces the remaining quota evenly across
+// the time left before reset, rather than letting the caller burn it
+// immediately and then hit a 429. It never loosens an existing rate, so a
+// response missing these headers (or an endpoint with a looser quota)
+// can't undo a tighter limit learned from an earlier response.
+func (l *rateLimiter) updateFromHeaders(headers http.Header) {
+	if l == nil {
+		return
+	}
+
+	limit, err := strconv.Atoi(headers.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(headers.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining < 0 {
+		return
+	}
+	resetSecs, err := strconv.ParseInt(headers.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	untilReset := time.Until(time.Unix(resetSecs, 0)).Seconds()
+	if untilReset <= 0 {
+		return
+	}
+
+	// Floor the numerator at 1 even when remaining is 0: dividing by a rate
+	// of exactly 0 would make wait() compute an infinite sleep instead of
+	// pacing requests out to arrive one per window, roughly at reset.
+	safeRate := float64(max(remaining, 1)) / untilReset
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if safeRate >= l.rate {
+		return
+	}
+	l.rate = safeRate
+	l.capacity = float64(limit)
+	if l.tokens > float64(remaining) {
+		l.tokens = float64(remaining)
+	}
+}
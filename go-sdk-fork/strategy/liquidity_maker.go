@@ -0,0 +1,168 @@
+package strategy
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+	"gopkg.in/yaml.v3"
+)
+
+// LiquidityMakerConfig describes a layered two-sided quoting strategy around
+// the current mark price of a single symbol.
+type LiquidityMakerConfig struct {
+	// Symbol is the trading pair to quote, e.g. "ADAUSDM".
+	Symbol string `yaml:"symbol"`
+	// Layers is the number of price levels quoted on each side.
+	Layers int `yaml:"layers"`
+	// Spread is the fractional distance of the first layer from the mark
+	// price; layer i (0-indexed) sits at Spread*(i+1) away.
+	Spread float64 `yaml:"spread"`
+	// Scale controls how order size grows across layers: "linear" sizes
+	// each layer equally, "exponential" doubles size per layer out from
+	// the mid.
+	Scale string `yaml:"scale"`
+	// MaxExposure is the total quantity per side (summed across that
+	// side's layers) the maker will have resting at once.
+	MaxExposure float64 `yaml:"maxExposure"`
+	// RequoteInterval is how often the maker cancels and replaces its
+	// quotes.
+	RequoteInterval time.Duration `yaml:"requoteInterval"`
+}
+
+// LoadLiquidityMakerConfig reads and parses a LiquidityMakerConfig from a
+// YAML file.
+func LoadLiquidityMakerConfig(path string) (*LiquidityMakerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("strategy: failed to read liquidity maker config %q: %w", path, err)
+	}
+
+	var cfg LiquidityMakerConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("strategy: failed to parse liquidity maker config %q: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// LiquidityMaker quotes Layers limit orders on each side of the mark price,
+// re-quoting on every RequoteInterval tick.
+type LiquidityMaker struct {
+	client *dd.DeltaDeFi
+	config *LiquidityMakerConfig
+
+	// restingOrderIDs tracks this maker's own resting quotes so requote
+	// only cancels orders it placed, not every open order on Symbol.
+	restingOrderIDs []string
+}
+
+// NewLiquidityMaker returns a LiquidityMaker driven by client and config.
+// The client's operation key must already be loaded before Run places
+// orders.
+func NewLiquidityMaker(client *dd.DeltaDeFi, config *LiquidityMakerConfig) *LiquidityMaker {
+	return &LiquidityMaker{client: client, config: config}
+}
+
+// Run re-quotes every RequoteInterval until stop is closed, cancelling this
+// maker's own resting quotes before placing each new round.
+func (m *LiquidityMaker) Run(stop <-chan struct{}) error {
+	ticker := time.NewTicker(m.config.RequoteInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.requote(); err != nil {
+			return err
+		}
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *LiquidityMaker) requote() error {
+	if len(m.restingOrderIDs) > 0 {
+		res, err := m.client.CancelOrders(m.restingOrderIDs)
+		if err != nil {
+			return fmt.Errorf("strategy: failed to cancel existing quotes: %w", err)
+		}
+		for _, leg := range res.Results {
+			if leg.Err != nil {
+				fmt.Printf("strategy: failed to cancel quote %s: %v\n", leg.OrderID, leg.Err)
+			}
+		}
+		m.restingOrderIDs = nil
+	}
+
+	priceRes, err := m.client.Market.GetMarketPrice(m.config.Symbol)
+	if err != nil {
+		return fmt.Errorf("strategy: failed to fetch mark price for %s: %w", m.config.Symbol, err)
+	}
+	mid := priceRes.Price
+
+	layerSizes := m.layerSizes()
+
+	var quotes []*dd.BuildPlaceOrderTransactionRequest
+	for i := 0; i < m.config.Layers; i++ {
+		offset := mid * m.config.Spread * float64(i+1)
+		bidPrice := mid - offset
+		askPrice := mid + offset
+		size := layerSizes[i]
+
+		quotes = append(quotes,
+			&dd.BuildPlaceOrderTransactionRequest{
+				Symbol:   dd.Symbol(m.config.Symbol),
+				Side:     dd.OrderSideBuy,
+				Type:     dd.OrderTypeLimit,
+				Quantity: size,
+				Price:    dd.FloatPtr(bidPrice),
+			},
+			&dd.BuildPlaceOrderTransactionRequest{
+				Symbol:   dd.Symbol(m.config.Symbol),
+				Side:     dd.OrderSideSell,
+				Type:     dd.OrderTypeLimit,
+				Quantity: size,
+				Price:    dd.FloatPtr(askPrice),
+			},
+		)
+	}
+
+	res, err := m.client.PostOrders(quotes)
+	if err != nil {
+		return fmt.Errorf("strategy: failed to place quotes: %w", err)
+	}
+	for i, leg := range res.Results {
+		if leg.Err != nil {
+			fmt.Printf("strategy: quote leg %d failed: %v\n", i, leg.Err)
+			continue
+		}
+		m.restingOrderIDs = append(m.restingOrderIDs, leg.Order.OrderID)
+	}
+	return nil
+}
+
+// layerSizes returns the per-layer order size for each of the config's
+// Layers, scaled per Scale and capped so the sum across one side never
+// exceeds MaxExposure.
+func (m *LiquidityMaker) layerSizes() []float64 {
+	weights := make([]float64, m.config.Layers)
+	var total float64
+	for i := range weights {
+		switch m.config.Scale {
+		case "exponential":
+			weights[i] = math.Pow(2, float64(i))
+		default: // "linear"
+			weights[i] = 1
+		}
+		total += weights[i]
+	}
+
+	sizes := make([]float64, m.config.Layers)
+	for i, weight := range weights {
+		sizes[i] = m.config.MaxExposure * weight / total
+	}
+	return sizes
+}
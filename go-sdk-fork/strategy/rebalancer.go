@@ -0,0 +1,172 @@
+// Package strategy provides built-in trading strategies (portfolio
+// rebalancing and layered liquidity making) driven by YAML config files, on
+// top of the base deltadefi client.
+package strategy
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+	"gopkg.in/yaml.v3"
+)
+
+// RebalancerConfig describes a target portfolio split between the two
+// assets of a single trading pair, e.g. keeping 60% of the portfolio's
+// value in ADA and 40% in USDM by trading ADAUSDM.
+type RebalancerConfig struct {
+	// DryRun logs the orders the rebalancer would place instead of sending
+	// them.
+	DryRun bool `yaml:"dryRun"`
+	// OnStart runs one rebalance pass immediately instead of waiting for
+	// the first PollInterval tick.
+	OnStart bool `yaml:"onStart"`
+	// Symbol is the trading pair used to move between the two target
+	// assets, e.g. "ADAUSDM".
+	Symbol string `yaml:"symbol"`
+	// TargetWeights maps each of the pair's two assets (e.g. "ADA",
+	// "USDM") to its target fraction of total portfolio value. Must sum to
+	// 1.0.
+	TargetWeights map[string]float64 `yaml:"targetWeights"`
+	// RebalanceThreshold is how far an asset's current weight may drift
+	// from its target before the rebalancer corrects it.
+	RebalanceThreshold float64 `yaml:"rebalanceThreshold"`
+	// PollInterval is how often the rebalancer checks portfolio weights.
+	PollInterval time.Duration `yaml:"pollInterval"`
+}
+
+// LoadRebalancerConfig reads and parses a RebalancerConfig from a YAML file.
+func LoadRebalancerConfig(path string) (*RebalancerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("strategy: failed to read rebalancer config %q: %w", path, err)
+	}
+
+	var cfg RebalancerConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("strategy: failed to parse rebalancer config %q: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Rebalancer keeps a two-asset portfolio near the weights in its config by
+// trading the configured symbol whenever an asset's weight drifts past
+// RebalanceThreshold.
+type Rebalancer struct {
+	client *dd.DeltaDeFi
+	config *RebalancerConfig
+}
+
+// NewRebalancer returns a Rebalancer driven by client and config. The
+// client's operation key must already be loaded before Run places orders.
+func NewRebalancer(client *dd.DeltaDeFi, config *RebalancerConfig) *Rebalancer {
+	return &Rebalancer{client: client, config: config}
+}
+
+// Run polls portfolio weights every PollInterval, correcting drift until
+// stop is closed. It runs one pass immediately if OnStart is set.
+func (r *Rebalancer) Run(stop <-chan struct{}) error {
+	if r.config.OnStart {
+		if err := r.RunOnce(); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+			if err := r.RunOnce(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// RunOnce checks current portfolio weights against the target and, for any
+// asset drifted beyond RebalanceThreshold, places a single market order on
+// Symbol to correct it.
+func (r *Rebalancer) RunOnce() error {
+	baseAsset, quoteAsset, err := splitSymbol(r.config.Symbol)
+	if err != nil {
+		return err
+	}
+
+	balances, err := r.client.Accounts.GetAccountBalance()
+	if err != nil {
+		return fmt.Errorf("strategy: failed to fetch account balance: %w", err)
+	}
+
+	priceRes, err := r.client.Market.GetMarketPrice(r.config.Symbol)
+	if err != nil {
+		return fmt.Errorf("strategy: failed to fetch mark price for %s: %w", r.config.Symbol, err)
+	}
+	price := priceRes.Price
+
+	var baseQty, quoteQty float64
+	for _, balance := range *balances {
+		switch balance.Asset {
+		case baseAsset:
+			baseQty = balance.Free + balance.Locked
+		case quoteAsset:
+			quoteQty = balance.Free + balance.Locked
+		}
+	}
+
+	totalValue := baseQty*price + quoteQty
+	if totalValue <= 0 {
+		return nil
+	}
+
+	baseWeight := (baseQty * price) / totalValue
+	targetBaseWeight := r.config.TargetWeights[baseAsset]
+	drift := baseWeight - targetBaseWeight
+
+	if drift > -r.config.RebalanceThreshold && drift < r.config.RebalanceThreshold {
+		return nil
+	}
+
+	// drift > 0 means the base asset is overweight, so sell the excess;
+	// drift < 0 means it's underweight, so buy to make up the shortfall.
+	excessBaseValue := drift * totalValue
+	quantity := excessBaseValue / price
+	side := dd.OrderSideSell
+	if quantity < 0 {
+		side = dd.OrderSideBuy
+		quantity = -quantity
+	}
+
+	order := &dd.BuildPlaceOrderTransactionRequest{
+		Symbol:   dd.Symbol(r.config.Symbol),
+		Side:     side,
+		Type:     dd.OrderTypeMarket,
+		Quantity: quantity,
+	}
+
+	if r.config.DryRun {
+		fmt.Printf("strategy: [dry run] would place %s %s %.6f %s\n", side, r.config.Symbol, quantity, baseAsset)
+		return nil
+	}
+
+	if _, err := r.client.PostOrder(order); err != nil {
+		return fmt.Errorf("strategy: failed to place rebalancing order: %w", err)
+	}
+	return nil
+}
+
+// splitSymbol splits a trading pair symbol like "ADAUSDM" into its base and
+// quote assets. DeltaDeFi symbols only ever pair a crypto asset against a
+// stablecoin quote (USDM or USDC), so the quote is recognized by suffix.
+func splitSymbol(symbol string) (base, quote string, err error) {
+	for _, q := range []string{"USDM", "USDC"} {
+		if len(symbol) > len(q) && symbol[len(symbol)-len(q):] == q {
+			return symbol[:len(symbol)-len(q)], q, nil
+		}
+	}
+	return "", "", fmt.Errorf("strategy: unrecognized symbol %q", symbol)
+}
@@ -0,0 +1,241 @@
+package deltadefi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// StreamClient subscribes to the DeltaDeFi websocket feeds (order book
+// depth, trades, klines, and authenticated user data) without the caller
+// having to manage the underlying connection or reconnects.
+type StreamClient struct {
+	client *Client
+}
+
+func newStreamClient(client *Client) *StreamClient {
+	return &StreamClient{client: client}
+}
+
+// DepthUpdate is an incremental order book update for a symbol.
+// FirstUpdateID/LastUpdateID bracket the range of book versions this
+// update applies, matching the semantics of MarketClient.GetOrderbookDepth's
+// LastUpdateID so a caller can detect gaps.
+type DepthUpdate struct {
+	Symbol        string        `json:"symbol"`
+	FirstUpdateID int64         `json:"first_update_id"`
+	LastUpdateID  int64         `json:"last_update_id"`
+	Bids          []MarketDepth `json:"bids"`
+	Asks          []MarketDepth `json:"asks"`
+}
+
+// TradeUpdate is a single executed trade on the public tape.
+type TradeUpdate struct {
+	Symbol    string  `json:"symbol"`
+	Price     float64 `json:"price"`
+	Quantity  float64 `json:"quantity"`
+	Side      string  `json:"side"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+// KlineUpdate is an in-progress or just-closed candlestick.
+type KlineUpdate struct {
+	Symbol   string  `json:"symbol"`
+	Interval string  `json:"interval"`
+	Open     float64 `json:"open"`
+	High     float64 `json:"high"`
+	Low      float64 `json:"low"`
+	Close    float64 `json:"close"`
+	Volume   float64 `json:"volume"`
+	IsClosed bool    `json:"is_closed"`
+}
+
+// UserDataEvent is an authenticated, account-scoped event: order status
+// changes, fills, deposits, and withdrawals all arrive on this one channel,
+// distinguished by EventType.
+type UserDataEvent struct {
+	EventType  string            `json:"event_type"`
+	Order      *OrderJSON        `json:"order,omitempty"`
+	Deposit    *DepositRecord    `json:"deposit,omitempty"`
+	Withdrawal *WithdrawalRecord `json:"withdrawal,omitempty"`
+}
+
+// streamEnvelope is the wire format every subscription channel is framed in:
+// a channel tag plus a raw payload that's decoded once the caller's typed
+// handler is known.
+type streamEnvelope struct {
+	Channel string          `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// Subscription is a handle to a live websocket subscription. Call Close to
+// stop receiving updates and release the underlying connection.
+type Subscription struct {
+	closeOnce sync.Once
+	done      chan struct{}
+
+	mu   sync.Mutex
+	conn *wsConn
+}
+
+// setConn installs the current connection, returning the previous one (if
+// any) so the caller can close it after the swap. Returns ok=false if the
+// subscription has already been closed, in which case newConn should be
+// closed by the caller instead of retained.
+func (s *Subscription) setConn(newConn *wsConn) (prev *wsConn, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.done:
+		return nil, false
+	default:
+	}
+	prev = s.conn
+	s.conn = newConn
+	return prev, true
+}
+
+// Close ends the subscription and closes its connection. Safe to call more
+// than once.
+func (s *Subscription) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.mu.Lock()
+		conn := s.conn
+		s.mu.Unlock()
+		err = conn.close()
+	})
+	return err
+}
+
+// reconnectDelay is the pause between a dropped connection and the next
+// dial/resubscribe attempt.
+const reconnectDelay = 2 * time.Second
+
+// subscribe dials the stream, sends a subscribe frame for channel, and
+// relays every payload that decodes into a streamEnvelope matching channel
+// to onMessage. The connection is transparently redialed and resubscribed
+// if it drops, until the returned Subscription is closed.
+func (s *StreamClient) subscribe(channel string, params map[string]string, onMessage func(json.RawMessage)) (*Subscription, error) {
+	conn, err := s.dialAndSubscribe(channel, params)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &Subscription{done: make(chan struct{}), conn: conn}
+
+	go func() {
+		currentConn := conn
+		for {
+			_, payload, err := currentConn.readMessage()
+			if err != nil {
+				select {
+				case <-sub.done:
+					currentConn.close()
+					return
+				default:
+				}
+
+				// currentConn is dead either way; close it before redialing
+				// rather than leaking it once it's replaced below.
+				currentConn.close()
+
+				time.Sleep(reconnectDelay)
+				newConn, err := s.dialAndSubscribe(channel, params)
+				if err != nil {
+					continue
+				}
+				if _, ok := sub.setConn(newConn); !ok {
+					// Close raced us: the subscription closed while we were
+					// redialing, so there's no one left to read newConn.
+					newConn.close()
+					return
+				}
+				currentConn = newConn
+				continue
+			}
+
+			var env streamEnvelope
+			if err := json.Unmarshal(payload, &env); err != nil || env.Channel != channel {
+				continue
+			}
+			onMessage(env.Data)
+		}
+	}()
+
+	return sub, nil
+}
+
+func (s *StreamClient) dialAndSubscribe(channel string, params map[string]string) (*wsConn, error) {
+	headers := http.Header{}
+	headers.Set("X-API-KEY", s.client.ApiKey)
+
+	conn, err := dialWebsocket(s.client.WsURL, headers)
+	if err != nil {
+		return nil, fmt.Errorf("deltadefi: failed to connect to stream: %w", err)
+	}
+
+	subscribeMsg, err := json.Marshal(map[string]interface{}{
+		"action":  "subscribe",
+		"channel": channel,
+		"params":  params,
+	})
+	if err != nil {
+		conn.close()
+		return nil, err
+	}
+
+	if err := conn.writeMessage(wsOpText, subscribeMsg); err != nil {
+		conn.close()
+		return nil, fmt.Errorf("deltadefi: failed to subscribe to %s: %w", channel, err)
+	}
+
+	return conn, nil
+}
+
+// SubscribeDepth streams order book updates for symbol.
+func (s *StreamClient) SubscribeDepth(symbol string, onUpdate func(*DepthUpdate)) (*Subscription, error) {
+	return s.subscribe("depth", map[string]string{"symbol": symbol}, func(data json.RawMessage) {
+		var update DepthUpdate
+		if err := json.Unmarshal(data, &update); err == nil {
+			onUpdate(&update)
+		}
+	})
+}
+
+// SubscribeTrades streams executed trades for symbol.
+func (s *StreamClient) SubscribeTrades(symbol string, onUpdate func(*TradeUpdate)) (*Subscription, error) {
+	return s.subscribe("trades", map[string]string{"symbol": symbol}, func(data json.RawMessage) {
+		var update TradeUpdate
+		if err := json.Unmarshal(data, &update); err == nil {
+			onUpdate(&update)
+		}
+	})
+}
+
+// SubscribeKlines streams candlestick updates for symbol at the given
+// interval (see the Interval constants in requests.go).
+func (s *StreamClient) SubscribeKlines(symbol string, interval Interval, onUpdate func(*KlineUpdate)) (*Subscription, error) {
+	params := map[string]string{"symbol": symbol, "interval": string(interval)}
+	return s.subscribe("klines", params, func(data json.RawMessage) {
+		var update KlineUpdate
+		if err := json.Unmarshal(data, &update); err == nil {
+			onUpdate(&update)
+		}
+	})
+}
+
+// SubscribeUserData streams authenticated, account-scoped events (order
+// updates, fills, deposits, withdrawals) for the account identified by the
+// client's API key.
+func (s *StreamClient) SubscribeUserData(onEvent func(*UserDataEvent)) (*Subscription, error) {
+	return s.subscribe("user-data", nil, func(data json.RawMessage) {
+		var event UserDataEvent
+		if err := json.Unmarshal(data, &event); err == nil {
+			onEvent(&event)
+		}
+	})
+}
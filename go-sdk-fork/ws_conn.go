@@ -0,0 +1,236 @@
+package deltadefi
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// websocketGUID is the fixed GUID used to compute Sec-WebSocket-Accept, per
+// RFC 6455 section 1.3.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	wsOpContinuation = 0x0
+	wsOpText         = 0x1
+	wsOpBinary       = 0x2
+	wsOpClose        = 0x8
+	wsOpPing         = 0x9
+	wsOpPong         = 0xA
+)
+
+// wsConn is a minimal RFC 6455 websocket client connection: enough to
+// perform the opening handshake and exchange unfragmented text/binary
+// frames, which is all the streaming subscriptions below need.
+type wsConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// dialWebsocket performs the websocket opening handshake against rawURL
+// (ws:// or wss://) and returns a connection ready for readMessage/
+// writeMessage.
+func dialWebsocket(rawURL string, headers http.Header) (*wsConn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("deltadefi: invalid websocket URL %q: %w", rawURL, err)
+	}
+
+	host := u.Host
+	if !strings.Contains(host, ":") {
+		if u.Scheme == "wss" {
+			host += ":443"
+		} else {
+			host += ":80"
+		}
+	}
+
+	dialer := net.Dialer{Timeout: 10 * time.Second}
+	var conn net.Conn
+	if u.Scheme == "wss" {
+		conn, err = tls.DialWithDialer(&dialer, "tcp", host, &tls.Config{ServerName: u.Hostname()})
+	} else {
+		conn, err = dialer.Dial("tcp", host)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("deltadefi: failed to dial %q: %w", rawURL, err)
+	}
+
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	encodedKey := base64.StdEncoding.EncodeToString(key)
+
+	requestPath := u.Path
+	if requestPath == "" {
+		requestPath = "/"
+	}
+	if u.RawQuery != "" {
+		requestPath += "?" + u.RawQuery
+	}
+
+	req := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{Path: requestPath},
+		Host:   u.Host,
+		Header: headers.Clone(),
+	}
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", encodedKey)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Host", u.Host)
+
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("deltadefi: failed to write websocket handshake: %w", err)
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("deltadefi: failed to read websocket handshake response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		conn.Close()
+		return nil, fmt.Errorf("deltadefi: websocket handshake rejected with status %d", resp.StatusCode)
+	}
+
+	expectedAccept := computeAcceptKey(encodedKey)
+	if resp.Header.Get("Sec-WebSocket-Accept") != expectedAccept {
+		conn.Close()
+		return nil, fmt.Errorf("deltadefi: websocket handshake failed Sec-WebSocket-Accept check")
+	}
+
+	return &wsConn{conn: conn, reader: br}, nil
+}
+
+func computeAcceptKey(clientKey string) string {
+	sum := sha1.Sum([]byte(clientKey + websocketGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// readMessage reads a single, unfragmented data frame and returns its
+// opcode and payload. Ping frames are answered with a pong and skipped;
+// close frames are surfaced as io.EOF.
+func (w *wsConn) readMessage() (byte, []byte, error) {
+	for {
+		header := make([]byte, 2)
+		if _, err := io.ReadFull(w.reader, header); err != nil {
+			return 0, nil, err
+		}
+
+		opcode := header[0] & 0x0F
+		masked := header[1]&0x80 != 0
+		payloadLen := int64(header[1] & 0x7F)
+
+		switch payloadLen {
+		case 126:
+			ext := make([]byte, 2)
+			if _, err := io.ReadFull(w.reader, ext); err != nil {
+				return 0, nil, err
+			}
+			payloadLen = int64(ext[0])<<8 | int64(ext[1])
+		case 127:
+			ext := make([]byte, 8)
+			if _, err := io.ReadFull(w.reader, ext); err != nil {
+				return 0, nil, err
+			}
+			payloadLen = 0
+			for _, b := range ext {
+				payloadLen = payloadLen<<8 | int64(b)
+			}
+		}
+
+		var maskKey [4]byte
+		if masked {
+			if _, err := io.ReadFull(w.reader, maskKey[:]); err != nil {
+				return 0, nil, err
+			}
+		}
+
+		payload := make([]byte, payloadLen)
+		if _, err := io.ReadFull(w.reader, payload); err != nil {
+			return 0, nil, err
+		}
+		if masked {
+			for i := range payload {
+				payload[i] ^= maskKey[i%4]
+			}
+		}
+
+		switch opcode {
+		case wsOpPing:
+			_ = w.writeMessage(wsOpPong, payload)
+			continue
+		case wsOpPong:
+			continue
+		case wsOpClose:
+			return opcode, payload, io.EOF
+		default:
+			return opcode, payload, nil
+		}
+	}
+}
+
+// writeMessage sends a single, unfragmented, masked frame as required of
+// clients by RFC 6455.
+func (w *wsConn) writeMessage(opcode byte, payload []byte) error {
+	var header []byte
+	header = append(header, 0x80|opcode) // FIN + opcode
+
+	length := len(payload)
+	switch {
+	case length <= 125:
+		header = append(header, 0x80|byte(length))
+	case length <= 0xFFFF:
+		header = append(header, 0x80|126, byte(length>>8), byte(length))
+	default:
+		ext := make([]byte, 8)
+		for i := 7; i >= 0; i-- {
+			ext[i] = byte(length)
+			length >>= 8
+		}
+		header = append(header, 0x80|127)
+		header = append(header, ext...)
+	}
+
+	var maskKey [4]byte
+	if _, err := rand.Read(maskKey[:]); err != nil {
+		return err
+	}
+	header = append(header, maskKey[:]...)
+
+	masked := make([]byte, len(payload))
+	for i, b := range payload {
+		masked[i] = b ^ maskKey[i%4]
+	}
+
+	if _, err := w.conn.Write(header); err != nil {
+		return err
+	}
+	_, err := w.conn.Write(masked)
+	return err
+}
+
+func (w *wsConn) close() error {
+	_ = w.writeMessage(wsOpClose, nil)
+	return w.conn.Close()
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+	"github.com/deltadefi-protocol/go-sdk/backtest"
+	"github.com/lpernett/godotenv"
+)
+
+// func main() {
+// 	runBacktest()
+// }
+
+// runBacktest replays ADAUSDM 1h bars through a simulated matching engine
+// and runs a buy-the-dip strategy that would otherwise hit the live client,
+// since backtest.SimulatedClient implements the same PostOrder/CancelOrder
+// surface as dd.DeltaDeFi.
+func runBacktest() {
+	godotenv.Load()
+	apiClient := dd.NewDeltaDeFi(dd.ApiConfig{Network: "staging"})
+
+	config := backtest.Config{
+		Client:          apiClient,
+		Symbols:         []string{"ADAUSDM"},
+		Interval:        dd.Interval1h,
+		Start:           time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:             time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		MakerFeeBps:     5,
+		TakerFeeBps:     10,
+		InitialBalances: map[string]float64{"ADA": 10000, "USDM": 5000},
+	}
+
+	client, err := backtest.NewSimulatedClient(config)
+	if err != nil {
+		fmt.Println("failed to build simulated client:", err)
+		return
+	}
+
+	var openOrderID string
+	for {
+		bar := client.CurrentBar("ADAUSDM")
+		if openOrderID == "" && bar.Close < 1.3 {
+			res, err := client.PostOrder(&dd.BuildPlaceOrderTransactionRequest{
+				Symbol:   "ADAUSDM",
+				Side:     dd.OrderSideBuy,
+				Type:     dd.OrderTypeLimit,
+				Quantity: 100.0,
+				Price:    dd.FloatPtr(bar.Close),
+			})
+			if err != nil {
+				fmt.Println("simulated order failed:", err)
+			} else {
+				openOrderID = res.Order.OrderID
+			}
+		}
+		if !client.Advance() {
+			break
+		}
+	}
+
+	report := client.Report()
+	fmt.Println("\nBacktest report:")
+	fmt.Printf("PnL: %.2f\n", report.PnL)
+	fmt.Printf("Sharpe: %.2f\n", report.Sharpe)
+	fmt.Printf("Max drawdown: %.2f%%\n", report.MaxDrawdownPct)
+	fmt.Printf("Win rate: %.2f%%\n", report.WinRatePct)
+	fmt.Printf("Fees paid: %.2f\n", report.FeesPaid)
+}
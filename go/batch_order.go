@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+	"github.com/lpernett/godotenv"
+)
+
+// func main() {
+// 	batchOrder()
+// }
+
+func batchOrder() {
+	godotenv.Load()
+	config := dd.ApiConfig{
+		Network:           "staging",
+		ApiKey:            os.Getenv("DELTADEFI_API_KEY"),
+		OperationPasscode: os.Getenv("ENCRYPTION_PASSCODE"),
+	}
+	client := dd.NewDeltaDeFi(config)
+	client.LoadOperationKey(config.OperationPasscode)
+
+	quotes := []*dd.BuildPlaceOrderTransactionRequest{
+		{Symbol: "ADAUSDM", Side: dd.OrderSideBuy, Type: dd.OrderTypeLimit, Quantity: 20.0, Price: dd.FloatPtr(1.2)},
+		{Symbol: "ADAUSDM", Side: dd.OrderSideBuy, Type: dd.OrderTypeLimit, Quantity: 20.0, Price: dd.FloatPtr(1.25)},
+		{Symbol: "ADAUSDM", Side: dd.OrderSideSell, Type: dd.OrderTypeLimit, Quantity: 20.0, Price: dd.FloatPtr(1.35)},
+		{Symbol: "ADAUSDM", Side: dd.OrderSideSell, Type: dd.OrderTypeLimit, Quantity: 20.0, Price: dd.FloatPtr(1.4)},
+	}
+
+	postOrdersRes, err := client.PostOrders(quotes)
+	if err != nil {
+		fmt.Println("batch order placement failed:", err)
+		return
+	}
+
+	fmt.Println("\nBatch order results:")
+	var orderIDs []string
+	for i, leg := range postOrdersRes.Results {
+		if leg.Err != nil {
+			fmt.Printf("leg %d failed: %v\n", i, leg.Err)
+			continue
+		}
+		fmt.Printf("leg %d placed: %s\n", i, leg.Order.OrderID)
+		orderIDs = append(orderIDs, leg.Order.OrderID)
+	}
+
+	cancelRes, err := client.CancelOrders(orderIDs)
+	if err != nil {
+		fmt.Println("batch cancel failed:", err)
+		return
+	}
+	fmt.Println("\nBatch cancel results:")
+	for i, leg := range cancelRes.Results {
+		if leg.Err != nil {
+			fmt.Printf("leg %d failed to cancel: %v\n", i, leg.Err)
+			continue
+		}
+		fmt.Printf("leg %d cancelled: %s\n", i, leg.OrderID)
+	}
+
+	fmt.Println("\nCancel-all for ADAUSDM:")
+	if err := client.CancelAllOrders("ADAUSDM"); err != nil {
+		fmt.Println("cancel-all failed:", err)
+		return
+	}
+	fmt.Println("Cancel-all Successful")
+}
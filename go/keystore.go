@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+	"github.com/deltadefi-protocol/go-sdk/keystore"
+	"github.com/lpernett/godotenv"
+)
+
+// func main() {
+// 	keystoreDemo()
+// }
+
+// keystoreDemo reads the operation key's passcode from the OS keychain
+// instead of the plaintext ENCRYPTION_PASSCODE env var used by the rest of
+// this demo, by passing a keystore.KeyProvider into NewDeltaDeFi. The same
+// ApiConfig/NewDeltaDeFi call works unchanged with keystore.NewVaultProvider
+// or keystore.NewKMSProvider in place of the OS keychain provider below.
+// keystore.NewHardwareWalletProvider is different: it implements
+// dd.TxSigner, not dd.KeyProvider, since a hardware wallet signs
+// transactions itself rather than handing out a passcode — wire it in with
+// dd.WithTxSigner(provider) and skip LoadOperationKey entirely.
+func keystoreDemo() {
+	godotenv.Load()
+	config := dd.ApiConfig{
+		Network: "staging",
+		ApiKey:  os.Getenv("DELTADEFI_API_KEY"),
+	}
+
+	provider, err := keystore.NewOSKeychainProvider("deltadefi-operation-key")
+	if err != nil {
+		fmt.Println("failed to open OS keychain provider:", err)
+		return
+	}
+
+	client := dd.NewDeltaDeFi(config, dd.WithKeyProvider(provider))
+
+	if err := client.LoadOperationKey(""); err != nil {
+		fmt.Println("failed to load operation key from keychain:", err)
+		return
+	}
+
+	orderPayload := &dd.BuildPlaceOrderTransactionRequest{
+		Symbol:   "ADAUSDM",
+		Side:     dd.OrderSideSell,
+		Type:     dd.OrderTypeLimit,
+		Quantity: 10.0,
+		Price:    dd.FloatPtr(1.5),
+	}
+	postOrderRes, err := client.PostOrder(orderPayload)
+	if err != nil {
+		fmt.Println("post order failed:", err)
+		return
+	}
+	fmt.Println("\nPost sell limit order via keychain-backed signing:")
+	fmt.Println(postOrderRes)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+	"github.com/deltadefi-protocol/go-sdk/strategy"
+	"github.com/lpernett/godotenv"
+)
+
+// func main() {
+// 	rebalance()
+// }
+
+// rebalance runs the portfolio rebalancer strategy from rebalancer.yaml
+// against the current account balances and mark price until interrupted.
+func rebalance() {
+	godotenv.Load()
+	config := dd.ApiConfig{
+		Network:           "staging",
+		ApiKey:            os.Getenv("DELTADEFI_API_KEY"),
+		OperationPasscode: os.Getenv("ENCRYPTION_PASSCODE"),
+	}
+	client := dd.NewDeltaDeFi(config)
+	client.LoadOperationKey(config.OperationPasscode)
+
+	rebalancerConfig, err := strategy.LoadRebalancerConfig("rebalancer.yaml")
+	if err != nil {
+		fmt.Println("failed to load rebalancer config:", err)
+		return
+	}
+
+	rebalancer := strategy.NewRebalancer(client, rebalancerConfig)
+	if err := rebalancer.Run(make(chan struct{})); err != nil {
+		fmt.Println("rebalancer run failed:", err)
+	}
+}
+
+// func main() {
+// 	marketMake()
+// }
+
+// marketMake runs the layered liquidity maker strategy from
+// liquidity_maker.yaml, re-quoting both sides around the mark price on the
+// configured interval until interrupted.
+func marketMake() {
+	godotenv.Load()
+	config := dd.ApiConfig{
+		Network:           "staging",
+		ApiKey:            os.Getenv("DELTADEFI_API_KEY"),
+		OperationPasscode: os.Getenv("ENCRYPTION_PASSCODE"),
+	}
+	client := dd.NewDeltaDeFi(config)
+	client.LoadOperationKey(config.OperationPasscode)
+
+	makerConfig, err := strategy.LoadLiquidityMakerConfig("liquidity_maker.yaml")
+	if err != nil {
+		fmt.Println("failed to load liquidity maker config:", err)
+		return
+	}
+
+	maker := strategy.NewLiquidityMaker(client, makerConfig)
+	if err := maker.Run(make(chan struct{})); err != nil {
+		fmt.Println("liquidity maker run failed:", err)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+	"github.com/lpernett/godotenv"
+)
+
+// func main() {
+// 	stream()
+// }
+
+// stream maintains a local ADAUSDM order book from client.Stream depth
+// updates, following the snapshot-then-diff pattern: fetch a REST snapshot,
+// then apply only the updates that land after it, resnapshotting if a gap
+// appears between consecutive updates.
+func stream() {
+	godotenv.Load()
+	config := dd.ApiConfig{
+		Network:           "staging",
+		ApiKey:            os.Getenv("DELTADEFI_API_KEY"),
+		OperationPasscode: os.Getenv("ENCRYPTION_PASSCODE"),
+	}
+	client := dd.NewDeltaDeFi(config)
+
+	book := newLocalOrderBook(client, "ADAUSDM")
+	if err := book.resnapshot(); err != nil {
+		fmt.Println("failed to fetch order book snapshot:", err)
+		return
+	}
+
+	depthSub, err := client.Stream.SubscribeDepth("ADAUSDM", func(update *dd.DepthUpdate) {
+		book.applyUpdate(update)
+		fmt.Printf("ADAUSDM book best bid/ask: %v / %v\n", book.bestBid(), book.bestAsk())
+	})
+	if err != nil {
+		fmt.Println("failed to subscribe to depth stream:", err)
+		return
+	}
+	defer depthSub.Close()
+
+	tradeSub, err := client.Stream.SubscribeTrades("ADAUSDM", func(trade *dd.TradeUpdate) {
+		fmt.Println("\nTrade:", trade)
+	})
+	if err != nil {
+		fmt.Println("failed to subscribe to trade stream:", err)
+		return
+	}
+	defer tradeSub.Close()
+
+	klineSub, err := client.Stream.SubscribeKlines("ADAUSDM", dd.Interval1h, func(kline *dd.KlineUpdate) {
+		fmt.Println("\n1h Kline:", kline)
+	})
+	if err != nil {
+		fmt.Println("failed to subscribe to kline stream:", err)
+		return
+	}
+	defer klineSub.Close()
+
+	userSub, err := client.Stream.SubscribeUserData(func(event *dd.UserDataEvent) {
+		fmt.Println("\nUser data event:", event)
+	})
+	if err != nil {
+		fmt.Println("failed to subscribe to user data stream:", err)
+		return
+	}
+	defer userSub.Close()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt)
+	<-stop
+}
+
+// localOrderBook is a minimal in-memory book for one symbol, keyed by price
+// level and kept in sync via resnapshot followed by applyUpdate.
+type localOrderBook struct {
+	client       *dd.DeltaDeFi
+	symbol       string
+	lastUpdateID int64
+	bids         map[float64]float64
+	asks         map[float64]float64
+}
+
+func newLocalOrderBook(client *dd.DeltaDeFi, symbol string) *localOrderBook {
+	return &localOrderBook{
+		client: client,
+		symbol: symbol,
+		bids:   make(map[float64]float64),
+		asks:   make(map[float64]float64),
+	}
+}
+
+// resnapshot re-fetches the REST order book snapshot and resets the local
+// book from it, discarding anything applied since the last snapshot.
+func (b *localOrderBook) resnapshot() error {
+	snapshot, err := b.client.Market.GetOrderbookDepth(b.symbol)
+	if err != nil {
+		return err
+	}
+	b.applySnapshot(snapshot)
+	return nil
+}
+
+func (b *localOrderBook) applySnapshot(snapshot *dd.GetMarketDepthResponse) {
+	b.bids = make(map[float64]float64, len(snapshot.Bids))
+	b.asks = make(map[float64]float64, len(snapshot.Asks))
+	for _, level := range snapshot.Bids {
+		b.bids[level.Price] = level.Quantity
+	}
+	for _, level := range snapshot.Asks {
+		b.asks[level.Price] = level.Quantity
+	}
+	b.lastUpdateID = snapshot.LastUpdateID
+}
+
+func (b *localOrderBook) applyUpdate(update *dd.DepthUpdate) {
+	if update.LastUpdateID <= b.lastUpdateID {
+		return // already covered by the snapshot
+	}
+	if update.FirstUpdateID != b.lastUpdateID+1 {
+		fmt.Println("gap detected in ADAUSDM depth stream, resnapshotting")
+		if err := b.resnapshot(); err != nil {
+			fmt.Println("failed to resnapshot order book:", err)
+		}
+		return
+	}
+	applyPriceLevels(b.bids, update.Bids)
+	applyPriceLevels(b.asks, update.Asks)
+	b.lastUpdateID = update.LastUpdateID
+}
+
+func applyPriceLevels(levels map[float64]float64, diffs []dd.MarketDepth) {
+	for _, level := range diffs {
+		if level.Quantity == 0 {
+			delete(levels, level.Price)
+			continue
+		}
+		levels[level.Price] = level.Quantity
+	}
+}
+
+func (b *localOrderBook) bestBid() float64 {
+	return bestPriceLevel(b.bids, true)
+}
+
+func (b *localOrderBook) bestAsk() float64 {
+	return bestPriceLevel(b.asks, false)
+}
+
+func bestPriceLevel(levels map[float64]float64, highest bool) float64 {
+	var best float64
+	first := true
+	for price := range levels {
+		if first || (highest && price > best) || (!highest && price < best) {
+			best = price
+			first = false
+		}
+	}
+	return best
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	dd "github.com/deltadefi-protocol/go-sdk"
+	"github.com/lpernett/godotenv"
+)
+
+// func main() {
+// 	transportDemo()
+// }
+
+// transportDemo configures the retryable transport's backoff and rate-limit
+// knobs and, unlike the other demo files in this repo, branches on the
+// typed errors it surfaces instead of discarding them.
+func transportDemo() {
+	godotenv.Load()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	config := dd.ApiConfig{
+		Network:           "staging",
+		ApiKey:            os.Getenv("DELTADEFI_API_KEY"),
+		OperationPasscode: os.Getenv("ENCRYPTION_PASSCODE"),
+		MaxRetries:        5,
+		RetryWaitMin:      500 * time.Millisecond,
+		RetryWaitMax:      10 * time.Second,
+		RequestsPerSecond: 10,
+		PerEndpointQPS:    map[string]float64{"/order": 2},
+		Logger:            logger,
+	}
+	client := dd.NewDeltaDeFi(config)
+
+	accountBalanceRes, err := client.Accounts.GetAccountBalance()
+	if err != nil {
+		switch e := err.(type) {
+		case *dd.RateLimitError:
+			fmt.Println("rate limited, retry after:", e.RetryAfter)
+		case *dd.AuthError:
+			fmt.Println("auth error:", e.Message)
+		case *dd.ValidationError:
+			fmt.Println("validation error:", e.Fields)
+		case *dd.ServerError:
+			fmt.Println("server error:", e.StatusCode)
+		default:
+			fmt.Println("unexpected error:", err)
+		}
+		return
+	}
+
+	fmt.Println("\nAccount Balance:")
+	for _, record := range *accountBalanceRes {
+		fmt.Println(record)
+	}
+}